@@ -25,10 +25,32 @@ const (
 	// representation of the location labels in order to use them in a table column in the CLI.
 	LocationLabelsStringAnnotationKey = "scheduling.kcp.dev/labels"
 
-	// PlacementAnnotationKey is the label key for the label holding a PlacementAnnotation struct.
+	// PlacementAnnotationKey is the annotation key for the annotation holding a JSON encoded
+	// PlacementAnnotation struct. An empty value means that the namespace is bound to placements,
+	// but no sync target has been scheduled yet.
 	PlacementAnnotationKey = "scheduling.kcp.dev/placement"
 )
 
+// PlacementAnnotation records the scheduling decisions of the placements a namespace is bound to.
+type PlacementAnnotation struct {
+	// placements are the decisions of the placements with a scheduled sync target, sorted by
+	// placement name.
+	Placements []PlacementDecision `json:"placements"`
+}
+
+// PlacementDecision describes the location and sync target chosen for one placement.
+type PlacementDecision struct {
+	// placement is the name of the placement.
+	Placement string `json:"placement"`
+
+	// location is the location selected by the placement.
+	Location LocationReference `json:"location"`
+
+	// syncTargetKey is the key of the sync target scheduled in the location, as used in the
+	// state.workload.kcp.dev/<sync-target-key> label.
+	SyncTargetKey string `json:"syncTargetKey"`
+}
+
 // Location represents a set of instances of a scheduling resource type acting a target
 // of scheduling.
 //
@@ -155,6 +177,35 @@ type LocationStatus struct {
 
 	// available is the number of actual instances that are available at this location.
 	AvailableInstances *uint32 `json:"availableInstances,omitempty"`
+
+	// notReadyInstances is the number of actual instances at this location whose
+	// Ready condition is not true. The number of ready instances, including unschedulable
+	// or evicting ones, is instances minus notReadyInstances.
+	NotReadyInstances *uint32 `json:"notReadyInstances,omitempty"`
+
+	// selectedInstances lists the actual instances at this location, sorted by name,
+	// together with their readiness.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	SelectedInstances []LocationInstance `json:"selectedInstances,omitempty"`
+}
+
+// LocationInstance is an actual instance selected by a location.
+type LocationInstance struct {
+	// name is the name of the instance, e.g. of the SyncTarget.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// ready is true if the Ready condition of the instance is true.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Ready bool `json:"ready"`
 }
 
 // LocationList is a list of locations.
@@ -150,6 +150,15 @@ const (
 	// LocationNotMatchReason is a reason for PlacementReady condition that no matched location for
 	// this placement can be found.
 	LocationNotMatchReason = "LocationNoMatch"
+
+	// PlacementScheduled is a condition type for placement representing that an instance of the
+	// selected location has been chosen for the placement. The placement is NOT scheduled when no
+	// instance of the selected location is available.
+	PlacementScheduled conditionsv1alpha1.ConditionType = "Scheduled"
+
+	// NoAvailableInstancesReason is a reason for PlacementScheduled condition that no instance of the
+	// selected location is ready and schedulable.
+	NoAvailableInstancesReason = "NoAvailableInstances"
 )
 
 // PlacementList is a list of locations.
@@ -93,6 +93,22 @@ func (in *Location) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationInstance) DeepCopyInto(out *LocationInstance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationInstance.
+func (in *LocationInstance) DeepCopy() *LocationInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocationList) DeepCopyInto(out *LocationList) {
 	*out = *in
@@ -184,6 +200,16 @@ func (in *LocationStatus) DeepCopyInto(out *LocationStatus) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.NotReadyInstances != nil {
+		in, out := &in.NotReadyInstances, &out.NotReadyInstances
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SelectedInstances != nil {
+		in, out := &in.SelectedInstances, &out.SelectedInstances
+		*out = make([]LocationInstance, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -225,6 +251,44 @@ func (in *Placement) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAnnotation) DeepCopyInto(out *PlacementAnnotation) {
+	*out = *in
+	if in.Placements != nil {
+		in, out := &in.Placements, &out.Placements
+		*out = make([]PlacementDecision, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAnnotation.
+func (in *PlacementAnnotation) DeepCopy() *PlacementAnnotation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAnnotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementDecision) DeepCopyInto(out *PlacementDecision) {
+	*out = *in
+	out.Location = in.Location
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementDecision.
+func (in *PlacementDecision) DeepCopy() *PlacementDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementList) DeepCopyInto(out *PlacementList) {
 	*out = *in
@@ -72,11 +72,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.AvailableSelectorLabel":                schema_pkg_apis_scheduling_v1alpha1_AvailableSelectorLabel(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.GroupVersionResource":                  schema_pkg_apis_scheduling_v1alpha1_GroupVersionResource(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.Location":                              schema_pkg_apis_scheduling_v1alpha1_Location(ref),
+		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationInstance":                      schema_pkg_apis_scheduling_v1alpha1_LocationInstance(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationList":                          schema_pkg_apis_scheduling_v1alpha1_LocationList(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationReference":                     schema_pkg_apis_scheduling_v1alpha1_LocationReference(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationSpec":                          schema_pkg_apis_scheduling_v1alpha1_LocationSpec(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationStatus":                        schema_pkg_apis_scheduling_v1alpha1_LocationStatus(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.Placement":                             schema_pkg_apis_scheduling_v1alpha1_Placement(ref),
+		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.PlacementAnnotation":                   schema_pkg_apis_scheduling_v1alpha1_PlacementAnnotation(ref),
+		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.PlacementDecision":                     schema_pkg_apis_scheduling_v1alpha1_PlacementDecision(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.PlacementList":                         schema_pkg_apis_scheduling_v1alpha1_PlacementList(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.PlacementSpec":                         schema_pkg_apis_scheduling_v1alpha1_PlacementSpec(ref),
 		"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.PlacementStatus":                       schema_pkg_apis_scheduling_v1alpha1_PlacementStatus(ref),
@@ -2254,6 +2257,36 @@ func schema_pkg_apis_scheduling_v1alpha1_Location(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_scheduling_v1alpha1_LocationInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocationInstance is an actual instance selected by a location.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the instance, e.g. of the SyncTarget.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ready is true if the Ready condition of the instance is true.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "ready"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_scheduling_v1alpha1_LocationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2412,9 +2445,40 @@ func schema_pkg_apis_scheduling_v1alpha1_LocationStatus(ref common.ReferenceCall
 							Format:      "int64",
 						},
 					},
+					"notReadyInstances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "notReadyInstances is the number of actual instances at this location whose Ready condition is not true. The number of ready instances, including unschedulable or evicting ones, is instances minus notReadyInstances.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"selectedInstances": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "selectedInstances lists the actual instances at this location, sorted by name, together with their readiness.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationInstance"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationInstance"},
 	}
 }
 
@@ -2465,6 +2529,75 @@ func schema_pkg_apis_scheduling_v1alpha1_Placement(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_scheduling_v1alpha1_PlacementAnnotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlacementAnnotation records the scheduling decisions of the placements a namespace is bound to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"placements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "placements are the decisions of the placements with a scheduled sync target, sorted by placement name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.PlacementDecision"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"placements"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.PlacementDecision"},
+	}
+}
+
+func schema_pkg_apis_scheduling_v1alpha1_PlacementDecision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlacementDecision describes the location and sync target chosen for one placement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"placement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "placement is the name of the placement.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "location is the location selected by the placement.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationReference"),
+						},
+					},
+					"syncTargetKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "syncTargetKey is the key of the sync target scheduled in the location, as used in the state.workload.kcp.dev/<sync-target-key> label.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"placement", "location", "syncTargetKey"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1.LocationReference"},
+	}
+}
+
 func schema_pkg_apis_scheduling_v1alpha1_PlacementList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
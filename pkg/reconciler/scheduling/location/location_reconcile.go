@@ -28,6 +28,8 @@ import (
 	utilserrors "k8s.io/apimachinery/pkg/util/errors"
 
 	schedulingv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
 	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
 )
 
@@ -87,12 +89,33 @@ func (r *statusReconciler) reconcile(ctx context.Context, location *schedulingv1
 		return reconcileStatusStop, err
 	}
 	available := len(FilterReady(locationClusters))
+	notReady := len(FilterNotReady(locationClusters))
 	location.Status.Instances = uint32Ptr(uint32(len(locationClusters)))
 	location.Status.AvailableInstances = uint32Ptr(uint32(available))
+	location.Status.NotReadyInstances = uint32Ptr(uint32(notReady))
+	location.Status.SelectedInstances = locationInstances(locationClusters)
 
 	return reconcileStatusContinue, nil
 }
 
+// locationInstances returns the given sync targets as location instances, sorted by name.
+func locationInstances(syncTargets []*workloadv1alpha1.SyncTarget) []schedulingv1alpha1.LocationInstance {
+	if len(syncTargets) == 0 {
+		return nil
+	}
+	ret := make([]schedulingv1alpha1.LocationInstance, 0, len(syncTargets))
+	for _, syncTarget := range syncTargets {
+		ret = append(ret, schedulingv1alpha1.LocationInstance{
+			Name:  syncTarget.Name,
+			Ready: conditions.IsTrue(syncTarget, conditionsv1alpha1.ReadyCondition),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
 func uint32Ptr(i uint32) *uint32 {
 	return &i
 }
@@ -50,6 +50,25 @@ func instances(expected uint32) func(t *testing.T, l *schedulingv1alpha1.Locatio
 	}
 }
 
+func notReadyInstances(expected uint32) func(t *testing.T, l *schedulingv1alpha1.Location) {
+	return func(t *testing.T, got *schedulingv1alpha1.Location) {
+		t.Helper()
+		require.NotNilf(t, got.Status.NotReadyInstances, "expected %d not ready instances, not nil", expected)
+		require.Equal(t, expected, *got.Status.NotReadyInstances)
+	}
+}
+
+func selectedInstances(expected ...schedulingv1alpha1.LocationInstance) func(t *testing.T, l *schedulingv1alpha1.Location) {
+	return func(t *testing.T, got *schedulingv1alpha1.Location) {
+		t.Helper()
+		if len(expected) == 0 {
+			require.Empty(t, got.Status.SelectedInstances)
+			return
+		}
+		require.Equal(t, expected, got.Status.SelectedInstances)
+	}
+}
+
 func labelString(expected string) func(t *testing.T, l *schedulingv1alpha1.Location) {
 	return func(t *testing.T, got *schedulingv1alpha1.Location) {
 		t.Helper()
@@ -111,12 +130,12 @@ func TestLocationStatusReconciler(t *testing.T) {
 	}{
 		"no SyncTargets": {
 			location:            usEast1,
-			wantLocation:        and(availableInstances(0), instances(0), labelString("continent=north-america country=usa")),
+			wantLocation:        and(availableInstances(0), instances(0), notReadyInstances(0), selectedInstances(), labelString("continent=north-america country=usa")),
 			wantReconcileStatus: reconcileStatusContinue,
 		},
 		"no SyncTargets, different label string": {
 			location:     usEast1WithoutLabelString,
-			wantLocation: and(availableInstances(0), instances(0), labelString("continent=north-america country=usa")),
+			wantLocation: and(availableInstances(0), instances(0), notReadyInstances(0), labelString("continent=north-america country=usa")),
 			wantUpdates: map[string]LocationCheck{
 				"us-east1": labelString("continent=north-america country=usa"),
 			},
@@ -139,9 +158,51 @@ func TestLocationStatusReconciler(t *testing.T) {
 					cluster("us-east1-2"),
 				},
 			},
-			wantLocation:        and(availableInstances(1), instances(4)),
+			wantLocation: and(availableInstances(1), instances(4), notReadyInstances(2), selectedInstances(
+				schedulingv1alpha1.LocationInstance{Name: "us-east1-1", Ready: false},
+				schedulingv1alpha1.LocationInstance{Name: "us-east1-2", Ready: false},
+				schedulingv1alpha1.LocationInstance{Name: "us-east1-3", Ready: true},
+				schedulingv1alpha1.LocationInstance{Name: "us-east1-4", Ready: true},
+			)),
+			wantReconcileStatus: reconcileStatusContinue,
+		},
+		"with two ready sync targets, only one matching the instance selector": {
+			location: usEast1,
+			syncTargets: map[logicalcluster.Name][]*workloadv1alpha1.SyncTarget{
+				logicalcluster.New("root:org:negotiation-workspace"): {
+					withLabels(withConditions(cluster("us-east1-1"), conditionsv1alpha1.Condition{Type: "Ready", Status: "True"}), map[string]string{"region": "us-east1"}),
+					withLabels(withConditions(cluster("us-west1-1"), conditionsv1alpha1.Condition{Type: "Ready", Status: "True"}), map[string]string{"region": "us-west1"}),
+				},
+			},
+			wantLocation:        and(availableInstances(1), instances(1), notReadyInstances(0), selectedInstances(schedulingv1alpha1.LocationInstance{Name: "us-east1-1", Ready: true})),
+			wantReconcileStatus: reconcileStatusContinue,
+		},
+		"with one ready and one not ready sync target": {
+			location: usEast1,
+			syncTargets: map[logicalcluster.Name][]*workloadv1alpha1.SyncTarget{
+				logicalcluster.New("root:org:negotiation-workspace"): {
+					withLabels(withConditions(cluster("us-east1-1"), conditionsv1alpha1.Condition{Type: "Ready", Status: "True"}), map[string]string{"region": "us-east1"}),
+					withLabels(withConditions(cluster("us-east1-2"), conditionsv1alpha1.Condition{Type: "Ready", Status: "False"}), map[string]string{"region": "us-east1"}),
+				},
+			},
+			wantLocation: and(availableInstances(1), instances(2), notReadyInstances(1), selectedInstances(
+				schedulingv1alpha1.LocationInstance{Name: "us-east1-1", Ready: true},
+				schedulingv1alpha1.LocationInstance{Name: "us-east1-2", Ready: false},
+			)),
 			wantReconcileStatus: reconcileStatusContinue,
 		},
+		"invalid instance selector": {
+			location: func() *schedulingv1alpha1.Location {
+				l := usEast1.DeepCopy()
+				l.Spec.InstanceSelector = &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "region", Operator: "Invalid"}},
+				}
+				return l
+			}(),
+			wantLocation:        labelString("continent=north-america country=usa"),
+			wantReconcileStatus: reconcileStatusStop,
+			wantError:           true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -57,6 +57,18 @@ func FilterReady(syncTargets []*workloadv1alpha1.SyncTarget) []*workloadv1alpha1
 	return ready
 }
 
+// FilterNotReady returns the sync targets whose Ready condition is not true, independent
+// of whether they are schedulable.
+func FilterNotReady(syncTargets []*workloadv1alpha1.SyncTarget) []*workloadv1alpha1.SyncTarget {
+	notReady := make([]*workloadv1alpha1.SyncTarget, 0, len(syncTargets))
+	for _, wc := range syncTargets {
+		if !conditions.IsTrue(wc, conditionsv1alpha1.ReadyCondition) {
+			notReady = append(notReady, wc)
+		}
+	}
+	return notReady
+}
+
 // FilterNonEvicting filters out the evicting sync targets.
 func FilterNonEvicting(syncTargets []*workloadv1alpha1.SyncTarget) []*workloadv1alpha1.SyncTarget {
 	ret := make([]*workloadv1alpha1.SyncTarget, 0, len(syncTargets))
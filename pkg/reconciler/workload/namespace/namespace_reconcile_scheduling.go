@@ -19,6 +19,7 @@ package namespace
 import (
 	"context"
 	"encoding/json"
+	"sort"
 	"strings"
 	"time"
 
@@ -39,7 +40,8 @@ const removingGracePeriod = 5 * time.Second
 
 // placementSchedulingReconciler reconciles the state.workload.kcp.dev/<syncTarget> labels according the
 // selected synctarget stored in the internal.workload.kcp.dev/synctarget annotation
-// on each placement.
+// on each placement. The resulting decisions are recorded in the scheduling.kcp.dev/placement
+// annotation of the namespace.
 type placementSchedulingReconciler struct {
 	listPlacement func(clusterName logicalcluster.Name) ([]*schedulingv1alpha1.Placement, error)
 
@@ -68,12 +70,20 @@ func (r *placementSchedulingReconciler) reconcile(ctx context.Context, ns *corev
 
 	// 1. pick all synctargets in all bound placements
 	scheduledSyncTargets := sets.NewString()
+	var decisions []schedulingv1alpha1.PlacementDecision
 	for _, placement := range validPlacements {
 		currentScheduled, foundScheduled := placement.Annotations[workloadv1alpha1.InternalSyncTargetPlacementAnnotationKey]
 		if !foundScheduled {
 			continue
 		}
 		scheduledSyncTargets.Insert(currentScheduled)
+		if placement.Status.SelectedLocation != nil {
+			decisions = append(decisions, schedulingv1alpha1.PlacementDecision{
+				Placement:     placement.Name,
+				Location:      *placement.Status.SelectedLocation,
+				SyncTargetKey: currentScheduled,
+			})
+		}
 	}
 
 	// 2. find the scheduled synctarget to the ns, including synced, removing
@@ -120,12 +130,23 @@ func (r *placementSchedulingReconciler) reconcile(ctx context.Context, ns *corev
 		logger.WithValues("syncTarget", scheduledSyncTarget).V(4).Info("setting syncTarget as sync for Namespace")
 	}
 
+	// 6. record the scheduling decisions in the placement annotation
+	if foundPlacement {
+		value, err := placementAnnotationValue(decisions)
+		if err != nil {
+			return reconcileStatusStop, ns, err
+		}
+		if value != ns.Annotations[schedulingv1alpha1.PlacementAnnotationKey] {
+			expectedAnnotations[schedulingv1alpha1.PlacementAnnotationKey] = value
+		}
+	}
+
 	if len(expectedLabels) > 0 || len(expectedAnnotations) > 0 {
 		ns, err := r.patchNamespaceLabelAnnotation(ctx, clusterName, ns, expectedLabels, expectedAnnotations)
 		return reconcileStatusContinue, ns, err
 	}
 
-	// 6. Requeue at last to check if removing syncTarget should be removed later.
+	// 7. Requeue at last to check if removing syncTarget should be removed later.
 	if minEnqueueDuration <= removingGracePeriod {
 		logger.WithValues("after", minEnqueueDuration).V(2).Info("enqueue Namespace later")
 		r.enqueueAfter(ns, minEnqueueDuration)
@@ -159,6 +180,22 @@ func (r *placementSchedulingReconciler) patchNamespaceLabelAnnotation(ctx contex
 	return updated, nil
 }
 
+// placementAnnotationValue encodes the given decisions as value of the placement annotation. It
+// returns an empty string if there is no decision.
+func placementAnnotationValue(decisions []schedulingv1alpha1.PlacementDecision) (string, error) {
+	if len(decisions) == 0 {
+		return "", nil
+	}
+	sort.Slice(decisions, func(i, j int) bool {
+		return decisions[i].Placement < decisions[j].Placement
+	})
+	bs, err := json.Marshal(schedulingv1alpha1.PlacementAnnotation{Placements: decisions})
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
 // syncedRemovingCluster finds synced and removing clusters for this ns.
 func syncedRemovingCluster(ns *corev1.Namespace) (sets.String, map[string]time.Time) {
 	synced := sets.NewString()
@@ -75,7 +75,7 @@ func TestScheduling(t *testing.T) {
 			placement: newPlacement("test-placement", "test-location", "test-cluster"),
 			wantPatch: true,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: `{"placements":[{"placement":"test-placement","location":{"path":"","locationName":"test-location"},"syncTargetKey":"34sZi3721YwBLDHUuNVIOLxuYp5nEZBpsTQyDq"}]}`,
 			},
 			expectedLabels: map[string]string{
 				workloadv1alpha1.ClusterResourceStateLabelPrefix + "34sZi3721YwBLDHUuNVIOLxuYp5nEZBpsTQyDq": string(workloadv1alpha1.ResourceStateSync),
@@ -84,7 +84,7 @@ func TestScheduling(t *testing.T) {
 		{
 			name: "no update when synctargets is scheduled",
 			annotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("test-placement", "test-location", "test-cluster")),
 			},
 			labels: map[string]string{
 				workloadv1alpha1.ClusterResourceStateLabelPrefix + "34sZi3721YwBLDHUuNVIOLxuYp5nEZBpsTQyDq": string(workloadv1alpha1.ResourceStateSync),
@@ -92,7 +92,7 @@ func TestScheduling(t *testing.T) {
 			placement: newPlacement("test-placement", "test-location", "test-cluster"),
 			wantPatch: false,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("test-placement", "test-location", "test-cluster")),
 			},
 			expectedLabels: map[string]string{
 				workloadv1alpha1.ClusterResourceStateLabelPrefix + "34sZi3721YwBLDHUuNVIOLxuYp5nEZBpsTQyDq": string(workloadv1alpha1.ResourceStateSync),
@@ -127,7 +127,7 @@ func TestScheduling(t *testing.T) {
 			placement: newPlacement("test-placement", "test-location", "test-cluster-2"),
 			wantPatch: true,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("test-placement", "test-location", "test-cluster-2")),
 				workloadv1alpha1.InternalClusterDeletionTimestampAnnotationPrefix + "34sZi3721YwBLDHUuNVIOLxuYp5nEZBpsTQyDq": now3339,
 			},
 			expectedLabels: map[string]string{
@@ -138,7 +138,7 @@ func TestScheduling(t *testing.T) {
 		{
 			name: "scheduled cluster is removing",
 			annotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("test-placement", "test-location", "test-cluster")),
 				workloadv1alpha1.InternalClusterDeletionTimestampAnnotationPrefix + "34sZi3721YwBLDHUuNVIOLxuYp5nEZBpsTQyDq": now3339,
 			},
 			labels: map[string]string{
@@ -147,7 +147,7 @@ func TestScheduling(t *testing.T) {
 			placement: newPlacement("test-placement", "test-location", "test-cluster"),
 			wantPatch: false,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("test-placement", "test-location", "test-cluster")),
 				workloadv1alpha1.InternalClusterDeletionTimestampAnnotationPrefix + "34sZi3721YwBLDHUuNVIOLxuYp5nEZBpsTQyDq": now3339,
 			},
 			expectedLabels: map[string]string{
@@ -232,7 +232,7 @@ func TestMultiplePlacements(t *testing.T) {
 			},
 			wantPatch: true,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("p1", "loc1", "c1"), decision("p2", "loc2", "c2")),
 			},
 			expectedLabels: map[string]string{
 				workloadv1alpha1.ClusterResourceStateLabelPrefix + "aPkhvUbGK0xoZIjMnM2pA0AuV1g7i4tBwxu5m4": string(workloadv1alpha1.ResourceStateSync),
@@ -250,7 +250,7 @@ func TestMultiplePlacements(t *testing.T) {
 			},
 			wantPatch: true,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("p1", "loc1", "c1"), decision("p2", "loc1", "c1")),
 			},
 			expectedLabels: map[string]string{
 				workloadv1alpha1.ClusterResourceStateLabelPrefix + "aQtdeEWVcqU7h7AKnYMm3KRQ96U4oU2W04yeOa": string(workloadv1alpha1.ResourceStateSync),
@@ -263,7 +263,7 @@ func TestMultiplePlacements(t *testing.T) {
 				newPlacement("p2", "loc2", "c2"),
 			},
 			annotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("p1", "loc1", "c1"), decision("p2", "loc2", "c2")),
 			},
 			labels: map[string]string{
 				workloadv1alpha1.ClusterResourceStateLabelPrefix + "aQtdeEWVcqU7h7AKnYMm3KRQ96U4oU2W04yeOa": string(workloadv1alpha1.ResourceStateSync),
@@ -271,7 +271,7 @@ func TestMultiplePlacements(t *testing.T) {
 			},
 			wantPatch: false,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("p1", "loc1", "c1"), decision("p2", "loc2", "c2")),
 			},
 			expectedLabels: map[string]string{
 				workloadv1alpha1.ClusterResourceStateLabelPrefix + "aQtdeEWVcqU7h7AKnYMm3KRQ96U4oU2W04yeOa": string(workloadv1alpha1.ResourceStateSync),
@@ -295,7 +295,7 @@ func TestMultiplePlacements(t *testing.T) {
 			},
 			wantPatch: true,
 			expectedAnnotations: map[string]string{
-				schedulingv1alpha1.PlacementAnnotationKey: "",
+				schedulingv1alpha1.PlacementAnnotationKey: placementAnnotation(t, decision("p1", "loc1", "c3"), decision("p2", "loc2", "c4")),
 				workloadv1alpha1.InternalClusterDeletionTimestampAnnotationPrefix + "aQtdeEWVcqU7h7AKnYMm3KRQ96U4oU2W04yeOa": now3339,
 				workloadv1alpha1.InternalClusterDeletionTimestampAnnotationPrefix + "aPkhvUbGK0xoZIjMnM2pA0AuV1g7i4tBwxu5m4": now3339,
 			},
@@ -362,3 +362,20 @@ func newPlacement(name, location, synctarget string) *schedulingv1alpha1.Placeme
 
 	return placement
 }
+
+func decision(placement, location, synctarget string) schedulingv1alpha1.PlacementDecision {
+	return schedulingv1alpha1.PlacementDecision{
+		Placement: placement,
+		Location: schedulingv1alpha1.LocationReference{
+			LocationName: location,
+		},
+		SyncTargetKey: workloadv1alpha1.ToSyncTargetKey(logicalcluster.New(""), synctarget),
+	}
+}
+
+func placementAnnotation(t *testing.T, decisions ...schedulingv1alpha1.PlacementDecision) string {
+	t.Helper()
+	value, err := placementAnnotationValue(decisions)
+	require.NoError(t, err)
+	return value
+}
@@ -18,14 +18,19 @@ package placement
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-logr/logr"
 	kcpcache "github.com/kcp-dev/apimachinery/pkg/cache"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -268,6 +273,13 @@ func (c *controller) processNextWorkItem(ctx context.Context) bool {
 }
 
 func (c *controller) process(ctx context.Context, key string) error {
+	logger := klog.FromContext(ctx)
+	clusterName, _, name, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		logger.Error(err, "invalid key")
+		return nil
+	}
+
 	obj, err := c.placementLister.Get(key) // TODO: clients need a way to scope down the lister per-cluster
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -275,12 +287,50 @@ func (c *controller) process(ctx context.Context, key string) error {
 		}
 		return err
 	}
+	old := obj
 	obj = obj.DeepCopy()
 
-	logger := logging.WithObject(klog.FromContext(ctx), obj)
+	logger = logging.WithObject(logger, obj)
 	ctx = klog.NewContext(ctx, logger)
 
-	reconcileErr := c.reconcile(ctx, obj)
+	updated, reconcileErr := c.reconcile(ctx, obj)
+	if reconcileErr != nil {
+		return reconcileErr
+	}
+
+	// If the object was patched, the status was computed from a stale object. The patch
+	// event requeues the placement, and the status is updated then.
+	if updated.ResourceVersion != old.ResourceVersion {
+		return nil
+	}
+
+	// If the status changed as a result, update it.
+	if !equality.Semantic.DeepEqual(old.Status, updated.Status) {
+		oldData, err := json.Marshal(schedulingv1alpha1.Placement{
+			Status: old.Status,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to Marshal old data for placement %s|%s: %w", clusterName, name, err)
+		}
+
+		newData, err := json.Marshal(schedulingv1alpha1.Placement{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:             old.UID,
+				ResourceVersion: old.ResourceVersion,
+			}, // to ensure they appear in the patch as preconditions
+			Status: updated.Status,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to Marshal new data for placement %s|%s: %w", clusterName, name, err)
+		}
+
+		patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
+		if err != nil {
+			return fmt.Errorf("failed to create patch for placement %s|%s: %w", clusterName, name, err)
+		}
+		_, err = c.patchPlacement(ctx, clusterName, name, types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status")
+		return err
+	}
 
-	return reconcileErr
+	return nil
 }
@@ -42,7 +42,7 @@ type reconciler interface {
 	reconcile(ctx context.Context, placement *schedulingv1alpha1.Placement) (reconcileStatus, *schedulingv1alpha1.Placement, error)
 }
 
-func (c *controller) reconcile(ctx context.Context, placement *schedulingv1alpha1.Placement) error {
+func (c *controller) reconcile(ctx context.Context, placement *schedulingv1alpha1.Placement) (*schedulingv1alpha1.Placement, error) {
 	reconcilers := []reconciler{
 		&placementSchedulingReconciler{
 			listSyncTarget: c.listSyncTarget,
@@ -65,7 +65,7 @@ func (c *controller) reconcile(ctx context.Context, placement *schedulingv1alpha
 		}
 	}
 
-	return utilserrors.NewAggregate(errs)
+	return placement, utilserrors.NewAggregate(errs)
 }
 
 func (c *controller) listSyncTarget(clusterName logicalcluster.Name) ([]*workloadv1alpha1.SyncTarget, error) {
@@ -30,6 +30,8 @@ import (
 	"k8s.io/klog/v2"
 
 	schedulingv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
 	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
 	locationreconciler "github.com/kcp-dev/kcp/pkg/reconciler/scheduling/location"
 )
@@ -51,12 +53,13 @@ func (r *placementSchedulingReconciler) reconcile(ctx context.Context, placement
 	currentScheduled, foundScheduled := placement.Annotations[workloadv1alpha1.InternalSyncTargetPlacementAnnotationKey]
 
 	// 2. pick all valid synctargets in this placements
-	syncTargetClusterName, syncTargets, err := r.getAllValidSyncTargetsForPlacement(clusterName, placement)
+	syncTargetClusterName, location, syncTargets, err := r.getAllValidSyncTargetsForPlacement(clusterName, placement)
 	if err != nil {
 		return reconcileStatusStop, placement, err
 	}
 
-	// no valid synctarget, clean the annotation.
+	// no valid synctarget, clean the annotation. The Scheduled condition is updated when
+	// the patch event requeues the placement.
 	if foundScheduled && len(syncTargets) == 0 {
 		expectedAnnotations[workloadv1alpha1.InternalSyncTargetPlacementAnnotationKey] = nil
 		updated, err := r.patchPlacementAnnotation(ctx, clusterName, placement, expectedAnnotations)
@@ -70,11 +73,13 @@ func (r *placementSchedulingReconciler) reconcile(ctx context.Context, placement
 			if syncTargetKey != currentScheduled {
 				continue
 			}
+			setScheduledCondition(placement, location, syncTargets)
 			return reconcileStatusContinue, placement, nil
 		}
 	}
 
-	// 3. randomly select one as the scheduled cluster
+	// 3. randomly select one as the scheduled cluster. The Scheduled condition is set when the
+	// patch event requeues the placement, i.e. only once the annotation is persisted.
 	// TODO(qiujian16): we currently schedule each in each location independently. It cannot guarantee 1 cluster is scheduled per location
 	// when the same synctargets are in multiple locations, we need to rethink whether we need a better algorithm or we need location
 	// to be exclusive.
@@ -85,12 +90,36 @@ func (r *placementSchedulingReconciler) reconcile(ctx context.Context, placement
 		return reconcileStatusContinue, updated, err
 	}
 
+	setScheduledCondition(placement, location, syncTargets)
 	return reconcileStatusContinue, placement, nil
 }
 
-func (r *placementSchedulingReconciler) getAllValidSyncTargetsForPlacement(clusterName logicalcluster.Name, placement *schedulingv1alpha1.Placement) (logicalcluster.Name, []*workloadv1alpha1.SyncTarget, error) {
+// setScheduledCondition reports on the placement whether it is scheduled to one of the given
+// valid sync targets of the selected location.
+func setScheduledCondition(placement *schedulingv1alpha1.Placement, location *schedulingv1alpha1.Location, syncTargets []*workloadv1alpha1.SyncTarget) {
+	switch {
+	case location == nil:
+		// no location is selected yet, or it does not exist. The Ready condition tells why.
+		conditions.Delete(placement, schedulingv1alpha1.PlacementScheduled)
+	case len(syncTargets) == 0:
+		conditions.MarkFalse(
+			placement,
+			schedulingv1alpha1.PlacementScheduled,
+			schedulingv1alpha1.NoAvailableInstancesReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"No available instances in location %s",
+			location.Name,
+		)
+	default:
+		conditions.MarkTrue(placement, schedulingv1alpha1.PlacementScheduled)
+	}
+}
+
+// getAllValidSyncTargetsForPlacement returns the selected location and its valid sync targets.
+// The location is nil if none is selected or it does not exist.
+func (r *placementSchedulingReconciler) getAllValidSyncTargetsForPlacement(clusterName logicalcluster.Name, placement *schedulingv1alpha1.Placement) (logicalcluster.Name, *schedulingv1alpha1.Location, []*workloadv1alpha1.SyncTarget, error) {
 	if placement.Status.Phase == schedulingv1alpha1.PlacementPending || placement.Status.SelectedLocation == nil {
-		return logicalcluster.Name{}, nil, nil
+		return logicalcluster.Name{}, nil, nil, nil
 	}
 
 	locationWorkspace := logicalcluster.New(placement.Status.SelectedLocation.Path)
@@ -99,27 +128,27 @@ func (r *placementSchedulingReconciler) getAllValidSyncTargetsForPlacement(clust
 		placement.Status.SelectedLocation.LocationName)
 	switch {
 	case errors.IsNotFound(err):
-		return locationWorkspace, nil, nil
+		return locationWorkspace, nil, nil, nil
 	case err != nil:
-		return locationWorkspace, nil, err
+		return locationWorkspace, nil, nil, err
 	}
 
 	// find all synctargets in the location workspace
 	syncTargets, err := r.listSyncTarget(locationWorkspace)
 	if err != nil {
-		return locationWorkspace, nil, err
+		return locationWorkspace, nil, nil, err
 	}
 
 	// filter the sync targets by location
 	locationClusters, err := locationreconciler.LocationSyncTargets(syncTargets, location)
 	if err != nil {
-		return locationWorkspace, nil, err
+		return locationWorkspace, nil, nil, err
 	}
 
 	// find all the valid sync targets.
 	validClusters := locationreconciler.FilterNonEvicting(locationreconciler.FilterReady(locationClusters))
 
-	return locationWorkspace, validClusters, nil
+	return locationWorkspace, location, validClusters, nil
 }
 
 func (r *placementSchedulingReconciler) patchPlacementAnnotation(ctx context.Context, clusterName logicalcluster.Name, placement *schedulingv1alpha1.Placement, annotations map[string]interface{}) (*schedulingv1alpha1.Placement, error) {
@@ -25,6 +25,7 @@ import (
 	"github.com/kcp-dev/logicalcluster/v2"
 	"github.com/stretchr/testify/require"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -46,15 +47,36 @@ func TestSchedulingReconcile(t *testing.T) {
 
 		wantPatch           bool
 		expectedAnnotations map[string]string
+		wantScheduled       corev1.ConditionStatus
+		wantReason          string
 	}{
 		{
 			name:      "no location",
 			placement: newPlacement("test", "test-location", ""),
 		},
 		{
-			name:      "no synctarget",
-			placement: newPlacement("test", "test-location", ""),
-			location:  newLocation("test-location"),
+			name: "no selected location",
+			placement: func() *schedulingv1alpha1.Placement {
+				placement := newPlacement("test", "", "")
+				placement.Status.Phase = schedulingv1alpha1.PlacementPending
+				placement.Status.SelectedLocation = nil
+				return placement
+			}(),
+		},
+		{
+			name:          "no synctarget",
+			placement:     newPlacement("test", "test-location", ""),
+			location:      newLocation("test-location"),
+			wantScheduled: corev1.ConditionFalse,
+			wantReason:    schedulingv1alpha1.NoAvailableInstancesReason,
+		},
+		{
+			name:          "no ready synctarget",
+			placement:     newPlacement("test", "test-location", ""),
+			location:      newLocation("test-location"),
+			syncTargets:   []*workloadv1alpha1.SyncTarget{newSyncTarget("c1", false)},
+			wantScheduled: corev1.ConditionFalse,
+			wantReason:    schedulingv1alpha1.NoAvailableInstancesReason,
 		},
 		{
 			name:        "schedule one synctarget",
@@ -74,6 +96,7 @@ func TestSchedulingReconcile(t *testing.T) {
 			expectedAnnotations: map[string]string{
 				workloadv1alpha1.InternalSyncTargetPlacementAnnotationKey: "aQtdeEWVcqU7h7AKnYMm3KRQ96U4oU2W04yeOa",
 			},
+			wantScheduled: corev1.ConditionTrue,
 		},
 		{
 			name:                "unschedule synctarget",
@@ -93,6 +116,16 @@ func TestSchedulingReconcile(t *testing.T) {
 				workloadv1alpha1.InternalSyncTargetPlacementAnnotationKey: "aPkhvUbGK0xoZIjMnM2pA0AuV1g7i4tBwxu5m4",
 			},
 		},
+		{
+			name:        "reschedule unschedulable synctarget",
+			placement:   newPlacement("test", "test-location", "c1"),
+			location:    newLocation("test-location"),
+			syncTargets: []*workloadv1alpha1.SyncTarget{unschedulable(newSyncTarget("c1", true)), newSyncTarget("c2", true)},
+			wantPatch:   true,
+			expectedAnnotations: map[string]string{
+				workloadv1alpha1.InternalSyncTargetPlacementAnnotationKey: "aPkhvUbGK0xoZIjMnM2pA0AuV1g7i4tBwxu5m4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -132,6 +165,15 @@ func TestSchedulingReconcile(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, testCase.wantPatch, patched)
 			require.Equal(t, testCase.expectedAnnotations, updated.Annotations)
+
+			scheduled := conditions.Get(updated, schedulingv1alpha1.PlacementScheduled)
+			if testCase.wantScheduled == "" {
+				require.Nil(t, scheduled)
+				return
+			}
+			require.NotNil(t, scheduled)
+			require.Equal(t, testCase.wantScheduled, scheduled.Status)
+			require.Equal(t, testCase.wantReason, scheduled.Reason)
 		})
 	}
 }
@@ -184,3 +226,8 @@ func newSyncTarget(name string, ready bool) *workloadv1alpha1.SyncTarget {
 
 	return syncTarget
 }
+
+func unschedulable(syncTarget *workloadv1alpha1.SyncTarget) *workloadv1alpha1.SyncTarget {
+	syncTarget.Spec.Unschedulable = true
+	return syncTarget
+}
@@ -354,6 +354,48 @@ func TestSyncerProcess(t *testing.T) {
 					"status"),
 			},
 		},
+		"StatusSyncer upsert to existing service": {
+			upstreamLogicalCluster: "root:org:ws",
+			fromNamespace: namespace("kcp0124d7647eb6a00b1fcb6f2252201601634989dd79deb7375c373973", "",
+				map[string]string{
+					"internal.workload.kcp.dev/cluster": "2gzO8uuQmIoZ2FE95zoOPKtrtGGXzzjAvtl6q5",
+				},
+				map[string]string{
+					"kcp.dev/namespace-locator": `{"syncTarget":{"workspace":"root:org:ws","name":"us-west1","uid":"syncTargetUID"},"workspace":"root:org:ws","namespace":"test"}`,
+				}),
+			gvr: schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"},
+			fromResource: changeService(
+				service("theService", "kcp0124d7647eb6a00b1fcb6f2252201601634989dd79deb7375c373973", "", map[string]string{
+					"internal.workload.kcp.dev/cluster": "2gzO8uuQmIoZ2FE95zoOPKtrtGGXzzjAvtl6q5",
+				}, nil, nil),
+				addServiceStatus(corev1.ServiceStatus{
+					LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.42"}},
+					},
+				})),
+			toResources: []runtime.Object{
+				service("theService", "test", "root:org:ws", map[string]string{
+					"state.workload.kcp.dev/2gzO8uuQmIoZ2FE95zoOPKtrtGGXzzjAvtl6q5": "Sync",
+				}, nil, nil),
+			},
+			resourceToProcessName: "theService",
+			syncTargetName:        "us-west1",
+
+			expectActionsOnFrom: []clienttesting.Action{},
+			expectActionsOnTo: []kcptesting.Action{
+				updateServiceAction("test",
+					toUnstructured(t, changeService(
+						service("theService", "test", "root:org:ws", map[string]string{
+							"state.workload.kcp.dev/2gzO8uuQmIoZ2FE95zoOPKtrtGGXzzjAvtl6q5": "Sync",
+						}, nil, nil),
+						addServiceStatus(corev1.ServiceStatus{
+							LoadBalancer: corev1.LoadBalancerStatus{
+								Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.42"}},
+							},
+						}))),
+					"status"),
+			},
+		},
 		"StatusSyncer upsert to existing resource but owned by another synctarget, expect no update": {
 			upstreamLogicalCluster: "root:org:ws",
 			fromNamespace: namespace("kcp0124d7647eb6a00b1fcb6f2252201601634989dd79deb7375c373973", "",
@@ -570,14 +612,7 @@ func TestSyncerProcess(t *testing.T) {
 			toClusterClient.ClearActions()
 
 			key := tc.fromNamespace.Name + "/" + tc.resourceToProcessName
-			err = controller.process(context.Background(),
-				schema.GroupVersionResource{
-					Group:    "apps",
-					Version:  "v1",
-					Resource: "deployments",
-				},
-				key,
-			)
+			err = controller.process(context.Background(), tc.gvr, key)
 			if tc.expectError {
 				assert.Error(t, err)
 			} else {
@@ -685,6 +720,40 @@ func addDeploymentStatus(status appsv1.DeploymentStatus) deploymentChange {
 	}
 }
 
+func service(name, namespace, clusterName string, labels, annotations map[string]string, finalizers []string) *corev1.Service {
+	if clusterName != "" {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[logicalcluster.AnnotationKey] = clusterName
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      labels,
+			Annotations: annotations,
+			Finalizers:  finalizers,
+		},
+	}
+}
+
+type serviceChange func(*corev1.Service)
+
+func changeService(in *corev1.Service, changes ...serviceChange) *corev1.Service {
+	for _, change := range changes {
+		change(in)
+	}
+	return in
+}
+
+func addServiceStatus(status corev1.ServiceStatus) serviceChange {
+	return func(s *corev1.Service) {
+		s.Status = status
+	}
+}
+
 func toUnstructured(t require.TestingT, obj metav1.Object) *unstructured.Unstructured {
 	var result unstructured.Unstructured
 	err := scheme.Convert(obj, &result, nil)
@@ -725,6 +794,23 @@ func updateDeploymentAction(namespace string, object runtime.Object, subresource
 	}
 }
 
+func serviceAction(verb, namespace string, subresources ...string) kcptesting.ActionImpl {
+	return kcptesting.ActionImpl{
+		Namespace:   namespace,
+		Cluster:     logicalcluster.New("root:org:ws"),
+		Verb:        verb,
+		Resource:    schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"},
+		Subresource: strings.Join(subresources, "/"),
+	}
+}
+
+func updateServiceAction(namespace string, object runtime.Object, subresources ...string) kcptesting.UpdateActionImpl {
+	return kcptesting.UpdateActionImpl{
+		ActionImpl: serviceAction("update", namespace, subresources...),
+		Object:     object,
+	}
+}
+
 type fakeSyncerInformers struct {
 	upstreamInformer   kcpkubernetesinformers.GenericClusterInformer
 	downStreamInformer informers.GenericInformer
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The KCP Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kcp-dev/logicalcluster/v2"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulingv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1"
+	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
+)
+
+type LocationOption func(location *schedulingv1alpha1.Location)
+
+// WithLocationLabels sets the labels of the location, which placements match with their location selectors.
+func WithLocationLabels(labels map[string]string) LocationOption {
+	return func(location *schedulingv1alpha1.Location) {
+		location.Labels = labels
+	}
+}
+
+// WithInstanceSelector sets the selector choosing the SyncTargets of the location.
+func WithInstanceSelector(selector metav1.LabelSelector) LocationOption {
+	return func(location *schedulingv1alpha1.Location) {
+		location.Spec.InstanceSelector = &selector
+	}
+}
+
+// NewLocationFixture creates a location of SyncTargets with the given name in the given workspace.
+// Without options, the location has no labels and selects all SyncTargets of the workspace.
+func NewLocationFixture(t *testing.T, server RunningServer, clusterName logicalcluster.Name, name string, options ...LocationOption) *schedulingv1alpha1.Location {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	clusterClient, err := kcpclient.NewForConfig(server.BaseConfig(t))
+	require.NoError(t, err, "failed to construct client for server")
+
+	tmpl := &schedulingv1alpha1.Location{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: schedulingv1alpha1.LocationSpec{
+			Resource: schedulingv1alpha1.GroupVersionResource{
+				Group:    "workload.kcp.dev",
+				Version:  "v1alpha1",
+				Resource: "synctargets",
+			},
+			InstanceSelector: &metav1.LabelSelector{},
+		},
+	}
+	for _, opt := range options {
+		opt(tmpl)
+	}
+
+	location, err := clusterClient.SchedulingV1alpha1().Locations().Create(logicalcluster.WithCluster(ctx, clusterName), tmpl, metav1.CreateOptions{})
+	require.NoError(t, err, "failed to create location %s in workspace %s", name, clusterName)
+
+	t.Logf("Created location %s in workspace %s", name, clusterName)
+	return location
+}
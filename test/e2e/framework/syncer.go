@@ -26,6 +26,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	kcpdynamic "github.com/kcp-dev/client-go/dynamic"
 	"github.com/kcp-dev/logicalcluster/v2"
 	"github.com/stretchr/testify/require"
@@ -33,8 +34,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -47,6 +50,7 @@ import (
 	apiresourcev1alpha1 "github.com/kcp-dev/kcp/pkg/apis/apiresource/v1alpha1"
 	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
 	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
 	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
 	workloadcliplugin "github.com/kcp-dev/kcp/pkg/cliplugins/workload/plugin"
 	"github.com/kcp-dev/kcp/pkg/syncer"
@@ -80,7 +84,7 @@ type syncerFixture struct {
 	syncTargetClusterName logicalcluster.Name
 	syncTargetName        string
 
-	extraResourcesToSync []string
+	extraResourcesToSync []schema.GroupResource
 	apiExports           []string
 	prepareDownstream    func(config *rest.Config, isFakePCluster bool)
 }
@@ -92,9 +96,20 @@ func WithSyncTarget(clusterName logicalcluster.Name, name string) SyncerOption {
 	}
 }
 
+// WithExtraResources adds resources to sync in the `resource.group` form accepted by
+// the --resources flag of the workload sync plugin, e.g. "services" or "cowboys.wildwest.dev".
 func WithExtraResources(resources ...string) SyncerOption {
+	grs := make([]schema.GroupResource, 0, len(resources))
+	for _, resource := range resources {
+		grs = append(grs, schema.ParseGroupResource(resource))
+	}
+	return WithExtraGroupResources(grs...)
+}
+
+// WithExtraGroupResources adds group-qualified resources to sync.
+func WithExtraGroupResources(grs ...schema.GroupResource) SyncerOption {
 	return func(t *testing.T, sf *syncerFixture) {
-		sf.extraResourcesToSync = append(sf.extraResourcesToSync, resources...)
+		sf.extraResourcesToSync = append(sf.extraResourcesToSync, grs...)
 	}
 }
 
@@ -141,8 +156,8 @@ func (sf *syncerFixture) Start(t *testing.T) *StartedSyncerFixture {
 		"--feature-gates=" + fmt.Sprintf("%s", utilfeature.DefaultFeatureGate),
 		"--api-import-poll-interval=5s",
 	}
-	for _, resource := range sf.extraResourcesToSync {
-		pluginArgs = append(pluginArgs, "--resources="+resource)
+	for _, gr := range sf.extraResourcesToSync {
+		pluginArgs = append(pluginArgs, "--resources="+gr.String())
 	}
 	for _, export := range sf.apiExports {
 		pluginArgs = append(pluginArgs, "--apiexports="+export)
@@ -355,6 +370,23 @@ func (sf *syncerFixture) Start(t *testing.T) *StartedSyncerFixture {
 	return startedSyncer
 }
 
+// StartSyncerFixtures starts one syncer per given sync target name in the given workspace and returns
+// the started fixtures in the same order. The given options are applied to every syncer.
+//
+// When running against fake pclusters each syncer gets its own downstream cluster. Deployed syncers
+// all target the single pcluster identified by --pcluster-kubeconfig though, i.e. the downstream
+// clients of all returned fixtures point to the same cluster. Tests must therefore tell the synced
+// objects of the sync targets apart by the internal.workload.kcp.dev/cluster label, not by the
+// downstream client they list them with.
+func StartSyncerFixtures(t *testing.T, server RunningServer, clusterName logicalcluster.Name, syncTargetNames []string, opts ...SyncerOption) []*StartedSyncerFixture {
+	started := make([]*StartedSyncerFixture, 0, len(syncTargetNames))
+	for _, syncTargetName := range syncTargetNames {
+		syncerOpts := append(append([]SyncerOption{}, opts...), WithSyncTarget(clusterName, syncTargetName))
+		started = append(started, NewSyncerFixture(t, server, clusterName, syncerOpts...).Start(t))
+	}
+	return started
+}
+
 // StartedSyncerFixture contains the configuration used to start a syncer and interact with its
 // downstream cluster.
 type StartedSyncerFixture struct {
@@ -378,6 +410,96 @@ func (sf *StartedSyncerFixture) WaitForClusterReady(t *testing.T, ctx context.Co
 	t.Logf("Cluster %q is %s", cfg.SyncTargetName, conditionsv1alpha1.ReadyCondition)
 }
 
+// RequireSynced waits for the given upstream object to show up with the same name in the
+// downstream cluster of the syncer, and requires the spec, labels and annotations of both
+// copies to be equal. Labels and annotations managed by the syncer are ignored. The upstream
+// client must be scoped to the workspace of the object.
+//
+// The spec is compared verbatim. Fields defaulted by the downstream cluster, e.g. clusterIP
+// of a Service, must be passed as dot-separated paths relative to the spec in
+// ignoredSpecFields.
+func RequireSynced(t *testing.T, upstreamClient dynamic.Interface, syncerFixture *StartedSyncerFixture, gvr schema.GroupVersionResource, namespace, name string, ignoredSpecFields ...string) {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	downstreamClient, err := dynamic.NewForConfig(syncerFixture.DownstreamConfig)
+	require.NoError(t, err)
+
+	cfg := syncerFixture.SyncerConfig
+	Eventually(t, func() (bool, string) {
+		upstream, err := upstreamClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("failed to get upstream %s %s/%s: %v", gvr.Resource, namespace, name, err)
+		}
+
+		downstreamNamespace := ""
+		if namespace != "" {
+			locator := shared.NewNamespaceLocator(logicalcluster.From(upstream), cfg.SyncTargetWorkspace, types.UID(cfg.SyncTargetUID), cfg.SyncTargetName, namespace)
+			downstreamNamespace, err = shared.PhysicalClusterNamespaceName(locator)
+			require.NoError(t, err, "failed to determine downstream namespace for %v", locator)
+		}
+		downstream, err := downstreamClient.Resource(gvr).Namespace(downstreamNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("failed to get downstream %s %s/%s: %v", gvr.Resource, downstreamNamespace, name, err)
+		}
+
+		if diff := cmp.Diff(syncedContent(upstream, ignoredSpecFields), syncedContent(downstream, ignoredSpecFields)); diff != "" {
+			return false, fmt.Sprintf("upstream %s %s/%s is not in sync (-upstream +downstream):\n%s", gvr.Resource, namespace, name, diff)
+		}
+		return true, ""
+	}, wait.ForeverTestTimeout, time.Millisecond*100, "expected upstream %s %s/%s to be synced", gvr.Resource, namespace, name)
+}
+
+// syncerManagedKeyPrefixes are the prefixes of the labels and annotations that the syncer
+// and the scheduling controllers add or strip on either side.
+var syncerManagedKeyPrefixes = []string{
+	logicalcluster.AnnotationKey,
+	shared.NamespaceLocatorAnnotation,
+	workloadv1alpha1.ClusterResourceStateLabelPrefix,
+	workloadv1alpha1.InternalDownstreamClusterLabel,
+	workloadv1alpha1.InternalClusterDeletionTimestampAnnotationPrefix,
+	workloadv1alpha1.ClusterFinalizerAnnotationPrefix,
+	workloadv1alpha1.InternalClusterStatusAnnotationPrefix,
+	workloadv1alpha1.ClusterSpecDiffAnnotationPrefix,
+}
+
+// syncedContent returns the parts of the object that the syncer copies downstream, without
+// the given spec fields.
+func syncedContent(obj *unstructured.Unstructured, ignoredSpecFields []string) map[string]interface{} {
+	obj = obj.DeepCopy()
+	for _, field := range ignoredSpecFields {
+		unstructured.RemoveNestedField(obj.Object, append([]string{"spec"}, strings.Split(field, ".")...)...)
+	}
+	return map[string]interface{}{
+		"spec":        obj.Object["spec"],
+		"labels":      withoutSyncerManagedKeys(obj.GetLabels()),
+		"annotations": withoutSyncerManagedKeys(obj.GetAnnotations()),
+	}
+}
+
+func withoutSyncerManagedKeys(in map[string]string) map[string]string {
+	var ret map[string]string
+	for k, v := range in {
+		managed := false
+		for _, prefix := range syncerManagedKeyPrefixes {
+			if strings.HasPrefix(k, prefix) {
+				managed = true
+				break
+			}
+		}
+		if managed {
+			continue
+		}
+		if ret == nil {
+			ret = map[string]string{}
+		}
+		ret[k] = v
+	}
+	return ret
+}
+
 // syncerConfigFromCluster reads the configuration needed to start an in-process
 // syncer from the resources applied to a cluster for a deployed syncer.
 func syncerConfigFromCluster(t *testing.T, downstreamConfig *rest.Config, namespace, syncerID string) *syncer.SyncerConfig {
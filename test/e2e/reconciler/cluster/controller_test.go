@@ -18,7 +18,6 @@ package cluster
 
 import (
 	"context"
-	"fmt"
 	"testing"
 	"time"
 
@@ -32,8 +31,10 @@ import (
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 
@@ -57,8 +58,9 @@ func TestClusterController(t *testing.T) {
 	framework.Suite(t, "transparent-multi-cluster")
 
 	type runningServer struct {
-		client     wildwestv1alpha1client.WildwestV1alpha1Interface
-		coreClient corev1client.CoreV1Interface
+		client        wildwestv1alpha1client.WildwestV1alpha1Interface
+		coreClient    corev1client.CoreV1Interface
+		dynamicClient dynamic.Interface
 	}
 	var testCases = []struct {
 		name string
@@ -111,21 +113,7 @@ func TestClusterController(t *testing.T) {
 				}, wait.ForeverTestTimeout, time.Millisecond*100, "expected namespace to be created in sink")
 
 				t.Logf("Expecting same spec to show up in sink")
-				framework.Eventually(t, func() (bool, string) {
-					if got, err := servers[sinkClusterName].client.Cowboys(targetNamespace).Get(ctx, cowboy.Name, metav1.GetOptions{}); err != nil {
-						if apierrors.IsNotFound(err) {
-							cowboy, err := servers[sourceClusterName].client.Cowboys(testNamespace).Get(ctx, cowboy.Name, metav1.GetOptions{})
-							if err != nil {
-								return false, fmt.Sprintf("error getting cowboy %q: %v", cowboy.Name, err)
-							}
-							return false, "Downstream cowboy couldn't be found."
-						}
-						return false, fmt.Sprintf("error getting cowboy %q in sink: %v", cowboy.Name, err)
-					} else if diff := cmp.Diff(cowboy.Spec, got.Spec); diff != "" {
-						return false, fmt.Sprintf("spec mismatch (-want +got):\n%s", diff)
-					}
-					return true, ""
-				}, wait.ForeverTestTimeout, time.Millisecond*100, "expected cowboy to be synced to sink with right spec")
+				framework.RequireSynced(t, servers[sourceClusterName].dynamicClient, syncerFixture, wildwestv1alpha1.SchemeGroupVersion.WithResource("cowboys"), testNamespace, cowboy.Name)
 
 				t.Logf("Patching status in sink")
 				updated, err := servers[sinkClusterName].client.Cowboys(targetNamespace).Patch(ctx, cowboy.Name, types.MergePatchType, []byte(`{"status":{"result":"giddyup"}}`), metav1.PatchOptions{}, "status")
@@ -182,9 +170,14 @@ func TestClusterController(t *testing.T) {
 			require.NoError(t, err)
 			sourceWildwestClient, err := wildwestclientset.NewForConfig(sourceWsClusterConfig)
 			require.NoError(t, err)
+			sourceDynamicClient, err := dynamic.NewForConfig(sourceWsClusterConfig)
+			require.NoError(t, err)
 
 			syncerFixture := framework.NewSyncerFixture(t, source, wsClusterName,
-				framework.WithExtraResources("cowboys.wildwest.dev", "services"),
+				framework.WithExtraGroupResources(
+					schema.GroupResource{Group: wildwest.GroupName, Resource: "cowboys"},
+					schema.GroupResource{Resource: "services"},
+				),
 				framework.WithDownstreamPreparation(func(config *rest.Config, isFakePCluster bool) {
 					// Always install the crd regardless of whether the target is
 					// logical or not since cowboys is not a native type.
@@ -215,8 +208,9 @@ func TestClusterController(t *testing.T) {
 
 			runningServers := map[string]runningServer{
 				sourceClusterName: {
-					client:     sourceWildwestClient.WildwestV1alpha1(),
-					coreClient: sourceKubeClient.Cluster(wsClusterName).CoreV1(),
+					client:        sourceWildwestClient.WildwestV1alpha1(),
+					coreClient:    sourceKubeClient.Cluster(wsClusterName).CoreV1(),
+					dynamicClient: sourceDynamicClient,
 				},
 				sinkClusterName: {
 					client:     sinkWildwestClient.WildwestV1alpha1(),
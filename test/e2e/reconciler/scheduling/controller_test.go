@@ -18,8 +18,10 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"reflect"
 	"testing"
 	"time"
 
@@ -31,15 +33,18 @@ import (
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/yaml"
 
 	apisv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/apis/v1alpha1"
 	schedulingv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1"
 	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
 	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
+	"github.com/kcp-dev/kcp/pkg/syncer/shared"
 	kubefixtures "github.com/kcp-dev/kcp/test/e2e/fixtures/kube"
 	"github.com/kcp-dev/kcp/test/e2e/framework"
 )
@@ -132,6 +137,10 @@ func TestScheduling(t *testing.T) {
 		return true, ""
 	}, wait.ForeverTestTimeout, time.Millisecond*100)
 
+	t.Log("Label the SyncTarget so that the location can select it")
+	_, err = kcpClusterClient.WorkloadV1alpha1().SyncTargets().Patch(logicalcluster.WithCluster(ctx, negotiationClusterName), syncTargetName, types.MergePatchType, []byte(`{"metadata":{"labels":{"region":"us-east1"}}}`), metav1.PatchOptions{})
+	require.NoError(t, err)
+
 	t.Log("Create a location in the negotiation workspace")
 	location := &schedulingv1alpha1.Location{
 		ObjectMeta: metav1.ObjectMeta{
@@ -144,6 +153,9 @@ func TestScheduling(t *testing.T) {
 				Version:  "v1alpha1",
 				Resource: "synctargets",
 			},
+			InstanceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"region": "us-east1"},
+			},
 		},
 	}
 	_, err = kcpClusterClient.SchedulingV1alpha1().Locations().Create(logicalcluster.WithCluster(ctx, negotiationClusterName), location, metav1.CreateOptions{})
@@ -159,12 +171,17 @@ func TestScheduling(t *testing.T) {
 		if actual, expected := *location.Status.AvailableInstances, uint32(1); actual != expected {
 			return false, fmt.Sprintf("location.Status.AvailableInstances is %d, not %d", actual, expected)
 		}
+		if actual, expected := location.Status.SelectedInstances, []schedulingv1alpha1.LocationInstance{{Name: syncTargetName, Ready: true}}; !reflect.DeepEqual(actual, expected) {
+			return false, fmt.Sprintf("location.Status.SelectedInstances is %v, not %v", actual, expected)
+		}
 		return true, ""
 	}, wait.ForeverTestTimeout, time.Millisecond*100)
 
 	t.Logf("Bind to location workspace")
 	framework.NewBindCompute(t, userClusterName, source,
 		framework.WithLocationWorkspaceWorkloadBindOption(negotiationClusterName),
+		// only match us-east1, not the "default" location created by apiexportcreate, so the placement annotation must name us-east1.
+		framework.WithLocationSelectorWorkloadBindOption(metav1.LabelSelector{MatchLabels: map[string]string{"foo": "42"}}),
 	).Bind(t)
 
 	t.Logf("Wait for being able to list Services in the user workspace")
@@ -265,12 +282,99 @@ func TestScheduling(t *testing.T) {
 	}
 	require.Equal(t, names.List(), []string{"first", "second"})
 
-	t.Logf("Wait for placement annotation on the default namespace")
+	t.Logf("Set a load balancer status on the downstream service of the first user workspace")
+	for _, downstreamService := range downstreamServices.Items {
+		if downstreamService.Name != "first" {
+			continue
+		}
+		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			svc, err := syncerFixture.DownstreamKubeClient.CoreV1().Services(downstreamService.Namespace).Get(ctx, downstreamService.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "10.0.0.42"}}
+			_, err = syncerFixture.DownstreamKubeClient.CoreV1().Services(svc.Namespace).UpdateStatus(ctx, svc, metav1.UpdateOptions{})
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	t.Logf("Wait for the service status to be synced back to the first user workspace")
+	framework.Eventually(t, func() (bool, string) {
+		svc, err := kubeClusterClient.Cluster(userClusterName).CoreV1().Services("default").Get(ctx, "first", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("Failed to get service: %v", err)
+		}
+		if len(svc.Status.LoadBalancer.Ingress) != 1 || svc.Status.LoadBalancer.Ingress[0].IP != "10.0.0.42" {
+			return false, fmt.Sprintf("upstream service status not synced yet: %v", svc.Status)
+		}
+		return true, ""
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Wait for the service in the second user workspace to get the syncer finalizer")
+	framework.Eventually(t, func() (bool, string) {
+		svc, err := kubeClusterClient.Cluster(secondUserClusterName).CoreV1().Services("default").Get(ctx, "second", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("Failed to get service: %v", err)
+		}
+		for _, finalizer := range svc.Finalizers {
+			if finalizer == shared.SyncerFinalizerNamePrefix+syncTargetKey {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("syncer finalizer not found on service: %v", svc.Finalizers)
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Delete the service in the second user workspace")
+	err = kubeClusterClient.Cluster(secondUserClusterName).CoreV1().Services("default").Delete(ctx, "second", metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	t.Logf("Wait for the downstream service to be deleted")
+	framework.Eventually(t, func() (bool, string) {
+		downstreamServices, err := syncerFixture.DownstreamKubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{
+			LabelSelector: "internal.workload.kcp.dev/cluster=" + syncTargetKey,
+		})
+		if err != nil {
+			return false, fmt.Sprintf("Failed to list services: %v", err)
+		}
+		for _, downstreamService := range downstreamServices.Items {
+			if downstreamService.Name == "second" {
+				return false, "downstream service still exists"
+			}
+		}
+		return true, ""
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Wait for the syncer finalizer to be removed and the upstream service to be gone")
+	framework.Eventually(t, func() (bool, string) {
+		svc, err := kubeClusterClient.Cluster(secondUserClusterName).CoreV1().Services("default").Get(ctx, "second", metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return true, ""
+		} else if err != nil {
+			return false, fmt.Sprintf("Failed to get service: %v", err)
+		}
+		return false, fmt.Sprintf("upstream service still exists with finalizers %v", svc.Finalizers)
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Wait for placement annotation on the default namespace to name the chosen location")
 	framework.Eventually(t, func() (bool, string) {
 		ns, err := kubeClusterClient.Cluster(userClusterName).CoreV1().Namespaces().Get(ctx, "default", metav1.GetOptions{})
 		require.NoError(t, err)
 
-		_, found := ns.Annotations[schedulingv1alpha1.PlacementAnnotationKey]
-		return found, fmt.Sprintf("no %s annotation:\n%s", schedulingv1alpha1.PlacementAnnotationKey, ns.Annotations)
+		value, found := ns.Annotations[schedulingv1alpha1.PlacementAnnotationKey]
+		if !found || value == "" {
+			return false, fmt.Sprintf("no scheduling decision in %s annotation:\n%s", schedulingv1alpha1.PlacementAnnotationKey, ns.Annotations)
+		}
+
+		var annotation schedulingv1alpha1.PlacementAnnotation
+		if err := json.Unmarshal([]byte(value), &annotation); err != nil {
+			return false, fmt.Sprintf("failed to decode %s annotation %q: %v", schedulingv1alpha1.PlacementAnnotationKey, value, err)
+		}
+		for _, decision := range annotation.Placements {
+			if decision.Location.LocationName == location.Name && decision.SyncTargetKey == syncTargetKey {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("expected location %s and SyncTarget %s in %s annotation, got %s", location.Name, syncTargetKey, schedulingv1alpha1.PlacementAnnotationKey, value)
 	}, wait.ForeverTestTimeout, time.Millisecond*100)
 }
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The KCP Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kcp-dev/logicalcluster/v2"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulingv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1"
+	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
+	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
+	"github.com/kcp-dev/kcp/test/e2e/framework"
+)
+
+// locationStatusUpdateTimeout bounds how long the location controller may take to reflect
+// a SyncTarget change. It is well below the resync period, i.e. the update must be event driven.
+const locationStatusUpdateTimeout = 10 * time.Second
+
+// TestLocationStatusSyncTargetChanges verifies that the instance counts of a Location are
+// updated promptly when matching SyncTargets are created and deleted.
+func TestLocationStatusSyncTargetChanges(t *testing.T) {
+	t.Parallel()
+	framework.Suite(t, "transparent-multi-cluster")
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	source := framework.SharedKcpServer(t)
+
+	orgClusterName := framework.NewOrganizationFixture(t, source)
+	locationClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
+
+	kcpClusterClient, err := kcpclient.NewForConfig(source.BaseConfig(t))
+	require.NoError(t, err)
+
+	t.Log("Create location")
+	location := &schedulingv1alpha1.Location{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "us-east1",
+		},
+		Spec: schedulingv1alpha1.LocationSpec{
+			Resource: schedulingv1alpha1.GroupVersionResource{
+				Group:    "workload.kcp.dev",
+				Version:  "v1alpha1",
+				Resource: "synctargets",
+			},
+			InstanceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"region": "us-east1"},
+			},
+		},
+	}
+	location, err = kcpClusterClient.SchedulingV1alpha1().Locations().Create(logicalcluster.WithCluster(ctx, locationClusterName), location, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	requireInstances := func(expected uint32) {
+		t.Helper()
+		framework.Eventually(t, func() (bool, string) {
+			location, err := kcpClusterClient.SchedulingV1alpha1().Locations().Get(logicalcluster.WithCluster(ctx, locationClusterName), location.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			if location.Status.Instances == nil {
+				return false, "location.Status.Instances not present"
+			}
+			if actual := *location.Status.Instances; actual != expected {
+				return false, fmt.Sprintf("location.Status.Instances is %d, not %d", actual, expected)
+			}
+			return true, ""
+		}, locationStatusUpdateTimeout, time.Millisecond*100)
+	}
+
+	t.Log("Wait for the location to report no instances")
+	requireInstances(0)
+
+	t.Log("Create a SyncTarget matching the location")
+	syncTarget := &workloadv1alpha1.SyncTarget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "us-east1-1",
+			Labels: map[string]string{"region": "us-east1"},
+		},
+	}
+	_, err = kcpClusterClient.WorkloadV1alpha1().SyncTargets().Create(logicalcluster.WithCluster(ctx, locationClusterName), syncTarget, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	t.Log("Wait for the location to count the new SyncTarget")
+	requireInstances(1)
+
+	t.Log("Delete the SyncTarget")
+	err = kcpClusterClient.WorkloadV1alpha1().SyncTargets().Delete(logicalcluster.WithCluster(ctx, locationClusterName), syncTarget.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	t.Log("Wait for the location to drop the deleted SyncTarget")
+	requireInstances(0)
+}
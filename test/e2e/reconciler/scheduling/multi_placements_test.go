@@ -35,7 +35,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 
-	schedulingv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1"
 	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
 	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
 	kubefixtures "github.com/kcp-dev/kcp/test/e2e/fixtures/kube"
@@ -54,6 +53,7 @@ func TestMultiPlacement(t *testing.T) {
 	orgClusterName := framework.NewOrganizationFixture(t, source)
 	locationClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
 	userClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
+	loc1UserClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
 
 	kubeClusterClient, err := kcpkubernetesclientset.NewForConfig(source.BaseConfig(t))
 	require.NoError(t, err)
@@ -65,30 +65,10 @@ func TestMultiPlacement(t *testing.T) {
 	require.Error(t, err)
 
 	firstSyncTargetName := fmt.Sprintf("synctarget-%d", +rand.Intn(1000000))
-	t.Logf("Creating a SyncTarget and syncer in %s", locationClusterName)
-	firstSyncerFixture := framework.NewSyncerFixture(t, source, locationClusterName,
-		framework.WithSyncTarget(locationClusterName, firstSyncTargetName),
-		framework.WithExtraResources("services"),
-		framework.WithDownstreamPreparation(func(config *rest.Config, isFakePCluster bool) {
-			if !isFakePCluster {
-				// Only need to install services and ingresses in a logical cluster
-				return
-			}
-			sinkCrdClient, err := apiextensionsclientset.NewForConfig(config)
-			require.NoError(t, err, "failed to create apiextensions client")
-			t.Logf("Installing test CRDs into sink cluster...")
-			kubefixtures.Create(t, sinkCrdClient.ApiextensionsV1().CustomResourceDefinitions(),
-				metav1.GroupResource{Group: "core.k8s.io", Resource: "services"},
-			)
-			require.NoError(t, err)
-		}),
-	).Start(t)
-
 	secondSyncTargetName := fmt.Sprintf("synctarget-%d", +rand.Intn(1000000))
-	t.Logf("Creating a SyncTarget and syncer in %s", locationClusterName)
-	secondSyncerFixture := framework.NewSyncerFixture(t, source, locationClusterName,
+	t.Logf("Creating two SyncTargets and syncers in %s", locationClusterName)
+	syncerFixtures := framework.StartSyncerFixtures(t, source, locationClusterName, []string{firstSyncTargetName, secondSyncTargetName},
 		framework.WithExtraResources("services"),
-		framework.WithSyncTarget(locationClusterName, secondSyncTargetName),
 		framework.WithDownstreamPreparation(func(config *rest.Config, isFakePCluster bool) {
 			if !isFakePCluster {
 				// Only need to install services and ingresses in a logical cluster
@@ -100,9 +80,11 @@ func TestMultiPlacement(t *testing.T) {
 			kubefixtures.Create(t, sinkCrdClient.ApiextensionsV1().CustomResourceDefinitions(),
 				metav1.GroupResource{Group: "core.k8s.io", Resource: "services"},
 			)
-			require.NoError(t, err)
 		}),
-	).Start(t)
+	)
+	firstSyncerFixture, secondSyncerFixture := syncerFixtures[0], syncerFixtures[1]
+	firstSyncTargetKey := workloadv1alpha1.ToSyncTargetKey(firstSyncerFixture.SyncerConfig.SyncTargetWorkspace, firstSyncTargetName)
+	secondSyncTargetKey := workloadv1alpha1.ToSyncTargetKey(secondSyncerFixture.SyncerConfig.SyncTargetWorkspace, secondSyncTargetName)
 
 	t.Log("Label synctarget")
 	patchData1 := `{"metadata":{"labels":{"loc":"loc1"}}}`
@@ -113,43 +95,12 @@ func TestMultiPlacement(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Log("Create locations")
-	loc1 := &schedulingv1alpha1.Location{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "loc1",
-			Labels: map[string]string{"loc": "loc1"},
-		},
-		Spec: schedulingv1alpha1.LocationSpec{
-			Resource: schedulingv1alpha1.GroupVersionResource{
-				Group:    "workload.kcp.dev",
-				Version:  "v1alpha1",
-				Resource: "synctargets",
-			},
-			InstanceSelector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"loc": "loc1"},
-			},
-		},
-	}
-	_, err = kcpClusterClient.SchedulingV1alpha1().Locations().Create(logicalcluster.WithCluster(ctx, locationClusterName), loc1, metav1.CreateOptions{})
-	require.NoError(t, err)
-
-	loc2 := &schedulingv1alpha1.Location{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   "loc2",
-			Labels: map[string]string{"loc": "loc2"},
-		},
-		Spec: schedulingv1alpha1.LocationSpec{
-			Resource: schedulingv1alpha1.GroupVersionResource{
-				Group:    "workload.kcp.dev",
-				Version:  "v1alpha1",
-				Resource: "synctargets",
-			},
-			InstanceSelector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"loc": "loc2"},
-			},
-		},
+	for _, name := range []string{"loc1", "loc2"} {
+		framework.NewLocationFixture(t, source, locationClusterName, name,
+			framework.WithLocationLabels(map[string]string{"loc": name}),
+			framework.WithInstanceSelector(metav1.LabelSelector{MatchLabels: map[string]string{"loc": name}}),
+		)
 	}
-	_, err = kcpClusterClient.SchedulingV1alpha1().Locations().Create(logicalcluster.WithCluster(ctx, locationClusterName), loc2, metav1.CreateOptions{})
-	require.NoError(t, err)
 
 	t.Logf("Bind user workspace to location workspace with loc 1")
 	framework.NewBindCompute(t, userClusterName, source,
@@ -201,11 +152,11 @@ func TestMultiPlacement(t *testing.T) {
 			return false, fmt.Sprintf("Failed to get service: %v", err)
 		}
 
-		if svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+workloadv1alpha1.ToSyncTargetKey(firstSyncerFixture.SyncerConfig.SyncTargetWorkspace, firstSyncTargetName)] != string(workloadv1alpha1.ResourceStateSync) {
+		if svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+firstSyncTargetKey] != string(workloadv1alpha1.ResourceStateSync) {
 			return false, fmt.Sprintf("%s is not added to ns annotation", firstSyncTargetName)
 		}
 
-		if svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+workloadv1alpha1.ToSyncTargetKey(secondSyncerFixture.SyncerConfig.SyncTargetWorkspace, secondSyncTargetName)] != string(workloadv1alpha1.ResourceStateSync) {
+		if svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+secondSyncTargetKey] != string(workloadv1alpha1.ResourceStateSync) {
 			return false, fmt.Sprintf("%s is not added to ns annotation", secondSyncTargetName)
 		}
 
@@ -215,7 +166,7 @@ func TestMultiPlacement(t *testing.T) {
 	t.Logf("Wait for the service to be sync to the downstream cluster")
 	framework.Eventually(t, func() (bool, string) {
 		downstreamServices, err := firstSyncerFixture.DownstreamKubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{
-			LabelSelector: "test.workload.kcp.dev=" + firstSyncTargetName,
+			LabelSelector: "internal.workload.kcp.dev/cluster=" + firstSyncTargetKey + ",test.workload.kcp.dev=" + firstSyncTargetName,
 		})
 
 		if err != nil {
@@ -230,7 +181,7 @@ func TestMultiPlacement(t *testing.T) {
 
 	framework.Eventually(t, func() (bool, string) {
 		downstreamServices, err := secondSyncerFixture.DownstreamKubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{
-			LabelSelector: "test.workload.kcp.dev=" + firstSyncTargetName,
+			LabelSelector: "internal.workload.kcp.dev/cluster=" + secondSyncTargetKey + ",test.workload.kcp.dev=" + firstSyncTargetName,
 		})
 
 		if err != nil {
@@ -242,4 +193,80 @@ func TestMultiPlacement(t *testing.T) {
 		}
 		return true, ""
 	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Bind another user workspace to location workspace with loc 1 only")
+	framework.NewBindCompute(t, loc1UserClusterName, source,
+		framework.WithLocationWorkspaceWorkloadBindOption(locationClusterName),
+		framework.WithLocationSelectorWorkloadBindOption(metav1.LabelSelector{MatchLabels: map[string]string{"loc": "loc1"}}),
+	).Bind(t)
+
+	t.Logf("Wait for being able to list Services in the loc 1 only user workspace")
+	require.Eventually(t, func() bool {
+		_, err := kubeClusterClient.Cluster(loc1UserClusterName).CoreV1().Services("").List(ctx, metav1.ListOptions{})
+		if errors.IsNotFound(err) {
+			return false
+		} else if err != nil {
+			t.Logf("Failed to list Services: %v", err)
+			return false
+		}
+		return true
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Create a service in the loc 1 only user workspace")
+	_, err = kubeClusterClient.Cluster(loc1UserClusterName).CoreV1().Services("default").Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "first",
+			Labels: map[string]string{
+				"test.workload.kcp.dev": "loc1-only",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Port:     80,
+					Protocol: corev1.ProtocolTCP,
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	t.Logf("Wait for the service to have the sync label of the first SyncTarget only")
+	framework.Eventually(t, func() (bool, string) {
+		svc, err := kubeClusterClient.Cluster(loc1UserClusterName).CoreV1().Services("default").Get(ctx, "first", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("Failed to get service: %v", err)
+		}
+
+		if svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+firstSyncTargetKey] != string(workloadv1alpha1.ResourceStateSync) {
+			return false, fmt.Sprintf("%s is not added to service labels", firstSyncTargetName)
+		}
+
+		if _, found := svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+secondSyncTargetKey]; found {
+			return false, fmt.Sprintf("%s should not be added to service labels", secondSyncTargetName)
+		}
+
+		return true, ""
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Wait for the service to be synced to the first downstream cluster")
+	framework.Eventually(t, func() (bool, string) {
+		downstreamServices, err := firstSyncerFixture.DownstreamKubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{
+			LabelSelector: "internal.workload.kcp.dev/cluster=" + firstSyncTargetKey + ",test.workload.kcp.dev=loc1-only",
+		})
+		if err != nil {
+			return false, fmt.Sprintf("Failed to list service: %v", err)
+		}
+		if len(downstreamServices.Items) < 1 {
+			return false, "service is not synced"
+		}
+		return true, ""
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Check that the service is not synced to the second downstream cluster")
+	downstreamServices, err := secondSyncerFixture.DownstreamKubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{
+		LabelSelector: "internal.workload.kcp.dev/cluster=" + secondSyncTargetKey + ",test.workload.kcp.dev=loc1-only",
+	})
+	require.NoError(t, err)
+	require.Empty(t, downstreamServices.Items, "service should not be synced to %s", secondSyncTargetName)
 }
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The KCP Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kcp-dev/logicalcluster/v2"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	apisv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/apis/v1alpha1"
+	schedulingv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/scheduling/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
+	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
+	"github.com/kcp-dev/kcp/test/e2e/framework"
+)
+
+// TestPlacementNoAvailableInstances verifies that a placement reports why it is not scheduled
+// when its location has no ready SyncTarget.
+func TestPlacementNoAvailableInstances(t *testing.T) {
+	t.Parallel()
+	framework.Suite(t, "transparent-multi-cluster")
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	source := framework.SharedKcpServer(t)
+
+	orgClusterName := framework.NewOrganizationFixture(t, source)
+	locationClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
+	userClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
+
+	kcpClusterClient, err := kcpclient.NewForConfig(source.BaseConfig(t))
+	require.NoError(t, err)
+
+	t.Log("Create a SyncTarget without a syncer, i.e. it never becomes ready")
+	syncTarget := &workloadv1alpha1.SyncTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "not-ready"},
+		Spec: workloadv1alpha1.SyncTargetSpec{
+			SupportedAPIExports: []apisv1alpha1.ExportReference{
+				{
+					Workspace: &apisv1alpha1.WorkspaceExportReference{
+						ExportName: "kubernetes",
+					},
+				},
+			},
+		},
+	}
+	_, err = kcpClusterClient.WorkloadV1alpha1().SyncTargets().Create(logicalcluster.WithCluster(ctx, locationClusterName), syncTarget, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	t.Log("Wait for the default location to show up")
+	framework.Eventually(t, func() (bool, string) {
+		_, err := kcpClusterClient.SchedulingV1alpha1().Locations().Get(logicalcluster.WithCluster(ctx, locationClusterName), "default", metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("failed to get default location: %v", err)
+		}
+		return true, ""
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+	t.Logf("Bind user workspace to location workspace")
+	placementName := "no-available-instances"
+	framework.NewBindCompute(t, userClusterName, source,
+		framework.WithLocationWorkspaceWorkloadBindOption(locationClusterName),
+		framework.WithAPIExportsWorkloadBindOption(locationClusterName.String()+":kubernetes"),
+		framework.WithPlacementNameBindOption(placementName),
+	).Bind(t)
+
+	t.Logf("Wait for the placement to report that it cannot be scheduled")
+	framework.Eventually(t, func() (bool, string) {
+		placement, err := kcpClusterClient.SchedulingV1alpha1().Placements().Get(logicalcluster.WithCluster(ctx, userClusterName), placementName, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("failed to get placement: %v", err)
+		}
+		if !conditions.IsFalse(placement, schedulingv1alpha1.PlacementScheduled) {
+			return false, fmt.Sprintf("expected condition %s to be false, got %v", schedulingv1alpha1.PlacementScheduled, placement.Status.Conditions)
+		}
+		if reason := conditions.GetReason(placement, schedulingv1alpha1.PlacementScheduled); reason != schedulingv1alpha1.NoAvailableInstancesReason {
+			return false, fmt.Sprintf("expected reason %s, got %s", schedulingv1alpha1.NoAvailableInstancesReason, reason)
+		}
+		return true, ""
+	}, wait.ForeverTestTimeout, time.Millisecond*100)
+}
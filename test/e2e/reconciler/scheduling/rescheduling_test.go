@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The KCP Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	kcpkubernetesclientset "github.com/kcp-dev/client-go/kubernetes"
+	"github.com/kcp-dev/logicalcluster/v2"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+
+	conditionsv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/kcp/pkg/apis/third_party/conditions/util/conditions"
+	workloadv1alpha1 "github.com/kcp-dev/kcp/pkg/apis/workload/v1alpha1"
+	kcpclient "github.com/kcp-dev/kcp/pkg/client/clientset/versioned"
+	kubefixtures "github.com/kcp-dev/kcp/test/e2e/fixtures/kube"
+	"github.com/kcp-dev/kcp/test/e2e/framework"
+)
+
+// TestRescheduling verifies that workloads move to another SyncTarget of the same location
+// when the scheduled SyncTarget stops being available, either because it is cordoned or
+// because it is not ready anymore.
+func TestRescheduling(t *testing.T) {
+	t.Parallel()
+	framework.Suite(t, "transparent-multi-cluster")
+
+	var testCases = []struct {
+		name string
+		// makeUnavailable makes the given SyncTarget unavailable for scheduling.
+		makeUnavailable func(ctx context.Context, t *testing.T, kcpClusterClient kcpclient.Interface, clusterName logicalcluster.Name, syncTargetName string)
+	}{
+		{
+			name: "cordoned",
+			makeUnavailable: func(ctx context.Context, t *testing.T, kcpClusterClient kcpclient.Interface, clusterName logicalcluster.Name, syncTargetName string) {
+				t.Logf("Cordon SyncTarget %s", syncTargetName)
+				_, err := kcpClusterClient.WorkloadV1alpha1().SyncTargets().Patch(logicalcluster.WithCluster(ctx, clusterName), syncTargetName, types.MergePatchType, []byte(`{"spec":{"unschedulable":true}}`), metav1.PatchOptions{})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "not ready",
+			makeUnavailable: func(ctx context.Context, t *testing.T, kcpClusterClient kcpclient.Interface, clusterName logicalcluster.Name, syncTargetName string) {
+				// The running syncer keeps heartbeating, which only keeps HeartbeatHealthy true. The
+				// heartbeat controller summarizes Ready from SyncerReady as well, so a false SyncerReady
+				// keeps the SyncTarget not ready.
+				t.Logf("Mark SyncTarget %s as not ready", syncTargetName)
+				err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+					syncTarget, err := kcpClusterClient.WorkloadV1alpha1().SyncTargets().Get(logicalcluster.WithCluster(ctx, clusterName), syncTargetName, metav1.GetOptions{})
+					if err != nil {
+						return err
+					}
+					conditions.MarkFalse(syncTarget, workloadv1alpha1.SyncerReady, "TestNotReady", conditionsv1alpha1.ConditionSeverityError, "Marked not ready by the test")
+					_, err = kcpClusterClient.WorkloadV1alpha1().SyncTargets().UpdateStatus(logicalcluster.WithCluster(ctx, clusterName), syncTarget, metav1.UpdateOptions{})
+					return err
+				})
+				require.NoError(t, err)
+
+				t.Logf("Wait for SyncTarget %s to be not ready", syncTargetName)
+				framework.Eventually(t, func() (bool, string) {
+					syncTarget, err := kcpClusterClient.WorkloadV1alpha1().SyncTargets().Get(logicalcluster.WithCluster(ctx, clusterName), syncTargetName, metav1.GetOptions{})
+					if err != nil {
+						return false, fmt.Sprintf("Failed to get SyncTarget: %v", err)
+					}
+					return conditions.IsFalse(syncTarget, conditionsv1alpha1.ReadyCondition), fmt.Sprintf("SyncTarget %s is still ready", syncTargetName)
+				}, wait.ForeverTestTimeout, time.Millisecond*100)
+			},
+		},
+	}
+
+	source := framework.SharedKcpServer(t)
+	orgClusterName := framework.NewOrganizationFixture(t, source)
+
+	for i := range testCases {
+		testCase := testCases[i]
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancelFunc := context.WithCancel(context.Background())
+			t.Cleanup(cancelFunc)
+
+			locationClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
+			userClusterName := framework.NewWorkspaceFixture(t, source, orgClusterName)
+
+			kubeClusterClient, err := kcpkubernetesclientset.NewForConfig(source.BaseConfig(t))
+			require.NoError(t, err)
+			kcpClusterClient, err := kcpclient.NewForConfig(source.BaseConfig(t))
+			require.NoError(t, err)
+
+			syncTargetNames := []string{
+				fmt.Sprintf("synctarget-%d", +rand.Intn(1000000)),
+				fmt.Sprintf("synctarget-%d", +rand.Intn(1000000)),
+			}
+			t.Logf("Creating two SyncTargets and syncers in %s", locationClusterName)
+			syncerFixtures := framework.StartSyncerFixtures(t, source, locationClusterName, syncTargetNames,
+				framework.WithExtraResources("services"),
+				framework.WithDownstreamPreparation(func(config *rest.Config, isFakePCluster bool) {
+					if !isFakePCluster {
+						// Only need to install services and ingresses in a logical cluster
+						return
+					}
+					sinkCrdClient, err := apiextensionsclientset.NewForConfig(config)
+					require.NoError(t, err, "failed to create apiextensions client")
+					t.Logf("Installing test CRDs into sink cluster...")
+					kubefixtures.Create(t, sinkCrdClient.ApiextensionsV1().CustomResourceDefinitions(),
+						metav1.GroupResource{Group: "core.k8s.io", Resource: "services"},
+					)
+				}),
+			)
+
+			syncTargetKeys := make([]string, 0, len(syncerFixtures))
+			for i, syncerFixture := range syncerFixtures {
+				syncTargetKeys = append(syncTargetKeys, workloadv1alpha1.ToSyncTargetKey(syncerFixture.SyncerConfig.SyncTargetWorkspace, syncTargetNames[i]))
+			}
+
+			t.Log("Create a location selecting both SyncTargets")
+			framework.NewLocationFixture(t, source, locationClusterName, "loc1",
+				framework.WithLocationLabels(map[string]string{"loc": "loc1"}),
+			)
+
+			t.Logf("Bind user workspace to location workspace with loc 1")
+			framework.NewBindCompute(t, userClusterName, source,
+				framework.WithLocationWorkspaceWorkloadBindOption(locationClusterName),
+				framework.WithLocationSelectorWorkloadBindOption(metav1.LabelSelector{MatchLabels: map[string]string{"loc": "loc1"}}),
+			).Bind(t)
+
+			t.Logf("Wait for being able to list Services in the user workspace")
+			require.Eventually(t, func() bool {
+				_, err := kubeClusterClient.Cluster(userClusterName).CoreV1().Services("").List(ctx, metav1.ListOptions{})
+				if errors.IsNotFound(err) {
+					return false
+				} else if err != nil {
+					t.Logf("Failed to list Services: %v", err)
+					return false
+				}
+				return true
+			}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+			t.Logf("Create a service in the user workspace")
+			_, err = kubeClusterClient.Cluster(userClusterName).CoreV1().Services("default").Create(ctx, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "first",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{
+							Port:     80,
+							Protocol: corev1.ProtocolTCP,
+						},
+					},
+				},
+			}, metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			t.Logf("Wait for the service to be scheduled to one of the SyncTargets")
+			scheduled := -1
+			framework.Eventually(t, func() (bool, string) {
+				svc, err := kubeClusterClient.Cluster(userClusterName).CoreV1().Services("default").Get(ctx, "first", metav1.GetOptions{})
+				if err != nil {
+					return false, fmt.Sprintf("Failed to get service: %v", err)
+				}
+				for i, syncTargetKey := range syncTargetKeys {
+					if svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+syncTargetKey] == string(workloadv1alpha1.ResourceStateSync) {
+						scheduled = i
+						return true, ""
+					}
+				}
+				return false, fmt.Sprintf("service is not scheduled to any SyncTarget: %v", svc.Labels)
+			}, wait.ForeverTestTimeout, time.Millisecond*100)
+			other := 1 - scheduled
+
+			testCase.makeUnavailable(ctx, t, kcpClusterClient, locationClusterName, syncTargetNames[scheduled])
+
+			t.Logf("Wait for the service to be rescheduled to SyncTarget %s", syncTargetNames[other])
+			framework.Eventually(t, func() (bool, string) {
+				svc, err := kubeClusterClient.Cluster(userClusterName).CoreV1().Services("default").Get(ctx, "first", metav1.GetOptions{})
+				if err != nil {
+					return false, fmt.Sprintf("Failed to get service: %v", err)
+				}
+				if svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+syncTargetKeys[other]] != string(workloadv1alpha1.ResourceStateSync) {
+					return false, fmt.Sprintf("service is not scheduled to %s: %v", syncTargetNames[other], svc.Labels)
+				}
+				if _, found := svc.Labels[workloadv1alpha1.ClusterResourceStateLabelPrefix+syncTargetKeys[scheduled]]; found {
+					return false, fmt.Sprintf("service is still scheduled to %s: %v", syncTargetNames[scheduled], svc.Labels)
+				}
+				return true, ""
+			}, wait.ForeverTestTimeout, time.Millisecond*100)
+
+			t.Logf("Wait for the service to be synced to the downstream cluster of SyncTarget %s", syncTargetNames[other])
+			framework.Eventually(t, func() (bool, string) {
+				downstreamServices, err := syncerFixtures[other].DownstreamKubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{
+					LabelSelector: "internal.workload.kcp.dev/cluster=" + syncTargetKeys[other],
+				})
+				if err != nil {
+					return false, fmt.Sprintf("Failed to list service: %v", err)
+				}
+				if len(downstreamServices.Items) < 1 {
+					return false, "service is not synced"
+				}
+				return true, ""
+			}, wait.ForeverTestTimeout, time.Millisecond*100)
+		})
+	}
+}